@@ -0,0 +1,11 @@
+package kong
+
+// ConsumerGroup represents a Consumer Group in Kong.
+// Consumer Groups allow consumers to be grouped together so that
+// plugins, such as rate-limiting, can be applied to the group as a whole.
+type ConsumerGroup struct {
+	ID        *string   `json:"id,omitempty"`
+	Name      *string   `json:"name,omitempty"`
+	Tags      []*string `json:"tags,omitempty"`
+	CreatedAt *int64    `json:"created_at,omitempty"`
+}
@@ -0,0 +1,24 @@
+package kong
+
+// Configuration is a map of configuration values for a plugin or a
+// vault entity in Kong.
+type Configuration map[string]interface{}
+
+// Plugin represents a Plugin in Kong.
+type Plugin struct {
+	ID     *string       `json:"id,omitempty"`
+	Name   *string       `json:"name,omitempty"`
+	Config Configuration `json:"config,omitempty"`
+
+	Service       *Service       `json:"service,omitempty"`
+	Route         *Route         `json:"route,omitempty"`
+	Consumer      *Consumer      `json:"consumer,omitempty"`
+	ConsumerGroup *ConsumerGroup `json:"consumer_group,omitempty"`
+
+	Protocols []*string `json:"protocols,omitempty"`
+	Enabled   *bool     `json:"enabled,omitempty"`
+	RunOn     *string   `json:"run_on,omitempty"`
+	Tags      []*string `json:"tags,omitempty"`
+
+	CreatedAt *int64 `json:"created_at,omitempty"`
+}
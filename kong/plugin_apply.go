@@ -0,0 +1,273 @@
+package kong
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// ApplyOptions controls how Apply reconciles a desired set of plugins
+// against Kong's current state.
+type ApplyOptions struct {
+	// Tags restricts the existing plugins considered for reconciliation to
+	// those carrying all of the given tags. An empty Tags considers every
+	// plugin currently in Kong.
+	Tags []string
+	// DryRun computes the plan without issuing any Create, Update or Delete
+	// calls against the Admin API.
+	DryRun bool
+	// ContinueOnError keeps applying the remaining plan after a single
+	// entity fails instead of aborting the rest of the run.
+	ContinueOnError bool
+}
+
+// ApplyAction is the operation Apply performed (or would perform) for a
+// single plugin.
+type ApplyAction string
+
+const (
+	// ApplyActionCreate indicates the plugin doesn't exist yet in Kong.
+	ApplyActionCreate ApplyAction = "create"
+	// ApplyActionUpdate indicates the plugin exists but drifted from desired.
+	ApplyActionUpdate ApplyAction = "update"
+	// ApplyActionDelete indicates the plugin exists in Kong but is no
+	// longer present in the desired set.
+	ApplyActionDelete ApplyAction = "delete"
+	// ApplyActionNoop indicates the plugin already matches desired state.
+	ApplyActionNoop ApplyAction = "noop"
+)
+
+// ApplyResult is the outcome of reconciling a single plugin.
+type ApplyResult struct {
+	Action   ApplyAction
+	Desired  *Plugin
+	Existing *Plugin
+	Error    error
+}
+
+// ApplyReport summarizes the outcome of an Apply call.
+type ApplyReport struct {
+	Results []ApplyResult
+}
+
+// HasErrors reports whether any entity in the report failed to apply.
+func (r ApplyReport) HasErrors() bool {
+	for _, result := range r.Results {
+		if result.Error != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply reconciles desired against Kong's current plugin state: plugins in
+// desired but not in Kong are created, plugins present in both are updated
+// if they've drifted, and plugins in Kong but absent from desired are
+// deleted. The plan is deterministic: entities are visited in the order
+// desired was given, followed by deletions ordered by their apply key.
+func (s *PluginService) Apply(ctx context.Context, desired []*Plugin,
+	opts ApplyOptions) (ApplyReport, error) {
+
+	existing, err := s.listExistingForApply(ctx, opts.Tags)
+	if err != nil {
+		return ApplyReport{}, err
+	}
+
+	plan := buildApplyPlan(existing, desired)
+
+	var report ApplyReport
+	for _, result := range plan {
+		if !opts.DryRun {
+			switch result.Action {
+			case ApplyActionCreate:
+				created, err := s.Create(ctx, result.Desired)
+				result.Error = err
+				if err == nil {
+					result.Desired = created
+				}
+			case ApplyActionUpdate:
+				toUpdate := *result.Desired
+				toUpdate.ID = result.Existing.ID
+				updated, err := s.Update(ctx, &toUpdate)
+				result.Error = err
+				if err == nil {
+					result.Desired = updated
+				}
+			case ApplyActionDelete:
+				result.Error = s.Delete(ctx, result.Existing.ID)
+			}
+		}
+
+		report.Results = append(report.Results, result)
+		if result.Error != nil && !opts.ContinueOnError {
+			return report, result.Error
+		}
+	}
+	return report, nil
+}
+
+// listExistingForApply fetches the current plugins Apply should reconcile
+// against, narrowed server-side to those carrying every tag in tags.
+func (s *PluginService) listExistingForApply(ctx context.Context,
+	tags []string) ([]*Plugin, error) {
+	if len(tags) == 0 {
+		return s.ListAll(ctx)
+	}
+
+	tagPtrs := make([]*string, len(tags))
+	for i := range tags {
+		tagPtrs[i] = &tags[i]
+	}
+	return s.ListAll(ctx, ListAllOpt{ListOpt: &ListOpt{Tags: tagPtrs, MatchAllTags: true}})
+}
+
+// buildApplyPlan matches desired plugins to existing ones by ID, falling
+// back to the (Name, Service|Route|Consumer|ConsumerGroup) apply key, and
+// emits a deterministic Create/Update/Noop/Delete plan.
+func buildApplyPlan(existing, desired []*Plugin) []ApplyResult {
+	byID := make(map[string]*Plugin, len(existing))
+	byKey := make(map[string]*Plugin, len(existing))
+	for _, plugin := range existing {
+		if !isEmptyString(plugin.ID) {
+			byID[*plugin.ID] = plugin
+		}
+		byKey[pluginApplyKey(plugin)] = plugin
+	}
+	matched := make(map[*Plugin]bool, len(existing))
+
+	var plan []ApplyResult
+	for _, want := range desired {
+		var have *Plugin
+		if !isEmptyString(want.ID) {
+			have = byID[*want.ID]
+		}
+		if have == nil {
+			have = byKey[pluginApplyKey(want)]
+		}
+
+		if have == nil {
+			plan = append(plan, ApplyResult{Action: ApplyActionCreate, Desired: want})
+			continue
+		}
+		matched[have] = true
+		if pluginsEqualForApply(have, want) {
+			plan = append(plan, ApplyResult{Action: ApplyActionNoop, Desired: want, Existing: have})
+		} else {
+			plan = append(plan, ApplyResult{Action: ApplyActionUpdate, Desired: want, Existing: have})
+		}
+	}
+
+	var deletions []ApplyResult
+	for _, plugin := range existing {
+		if !matched[plugin] {
+			deletions = append(deletions, ApplyResult{Action: ApplyActionDelete, Existing: plugin})
+		}
+	}
+	sort.Slice(deletions, func(i, j int) bool {
+		return pluginApplyKey(deletions[i].Existing) < pluginApplyKey(deletions[j].Existing)
+	})
+
+	return append(plan, deletions...)
+}
+
+// pluginApplyKey identifies a plugin by its name and scope, used to match
+// desired plugins against existing ones when no ID is given.
+func pluginApplyKey(plugin *Plugin) string {
+	name := strOrEmpty(plugin.Name)
+	switch {
+	case plugin.Service != nil:
+		return fmt.Sprintf("service/%s/%s", strOrEmpty(plugin.Service.ID), name)
+	case plugin.Route != nil:
+		return fmt.Sprintf("route/%s/%s", strOrEmpty(plugin.Route.ID), name)
+	case plugin.Consumer != nil:
+		return fmt.Sprintf("consumer/%s/%s", strOrEmpty(plugin.Consumer.ID), name)
+	case plugin.ConsumerGroup != nil:
+		return fmt.Sprintf("consumer_group/%s/%s", strOrEmpty(plugin.ConsumerGroup.ID), name)
+	default:
+		return fmt.Sprintf("global/%s", name)
+	}
+}
+
+// pluginsEqualForApply reports whether want already matches have closely
+// enough that no Update call is needed. It compares Name, Config, Enabled,
+// RunOn, Tags and Protocols; other fields, such as the scoping reference,
+// are not compared. A desired plugin without an ID whose scope differs
+// gets a different pluginApplyKey and so matches a different existing
+// plugin; one matched by ID is not checked for a scope change.
+func pluginsEqualForApply(have, want *Plugin) bool {
+	if strOrEmpty(have.Name) != strOrEmpty(want.Name) {
+		return false
+	}
+	if !boolPtrsEqual(have.Enabled, want.Enabled) {
+		return false
+	}
+	if !strOrEmptyPtrsEqual(have.RunOn, want.RunOn) {
+		return false
+	}
+	if !strPtrSetsEqual(have.Tags, want.Tags) {
+		return false
+	}
+	if !strPtrSetsEqual(have.Protocols, want.Protocols) {
+		return false
+	}
+	return configsEqual(have.Config, want.Config)
+}
+
+// strPtrSetsEqual compares two []*string as sets, ignoring order.
+func strPtrSetsEqual(a, b []*string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[string]int, len(a))
+	for _, s := range a {
+		counts[strOrEmpty(s)]++
+	}
+	for _, s := range b {
+		counts[strOrEmpty(s)]--
+	}
+	for _, n := range counts {
+		if n != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// configsEqual does a shallow comparison of two plugin configurations.
+func configsEqual(a, b Configuration) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || fmt.Sprint(bv) != fmt.Sprint(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// boolPtrsEqual compares two *bool, treating nil as distinct from any set
+// value rather than as "don't care" — a desired false against an existing
+// nil is drift, not a match.
+func boolPtrsEqual(a, b *bool) bool {
+	if (a == nil) != (b == nil) {
+		return false
+	}
+	return a == nil || *a == *b
+}
+
+// strOrEmptyPtrsEqual compares two *string the same way, so a desired
+// RunOn against an unset existing RunOn is reported as drift.
+func strOrEmptyPtrsEqual(a, b *string) bool {
+	if (a == nil) != (b == nil) {
+		return false
+	}
+	return a == nil || *a == *b
+}
+
+func strOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
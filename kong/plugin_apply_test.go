@@ -0,0 +1,88 @@
+package kong
+
+import "testing"
+
+func strPtr(s string) *string { return &s }
+
+func TestPluginApplyKey(t *testing.T) {
+	cases := []struct {
+		name   string
+		plugin *Plugin
+		want   string
+	}{
+		{"global", &Plugin{Name: strPtr("key-auth")}, "global/key-auth"},
+		{
+			"service scoped",
+			&Plugin{Name: strPtr("rate-limiting"), Service: &Service{ID: strPtr("svc-1")}},
+			"service/svc-1/rate-limiting",
+		},
+		{
+			"consumer group scoped",
+			&Plugin{Name: strPtr("rate-limiting"), ConsumerGroup: &ConsumerGroup{ID: strPtr("cg-1")}},
+			"consumer_group/cg-1/rate-limiting",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := pluginApplyKey(c.plugin); got != c.want {
+				t.Errorf("pluginApplyKey() = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestBuildApplyPlan(t *testing.T) {
+	existing := []*Plugin{
+		{ID: strPtr("1"), Name: strPtr("key-auth"), Enabled: boolPtr(true)},
+		{ID: strPtr("2"), Name: strPtr("rate-limiting"), Enabled: boolPtr(true)},
+	}
+	desired := []*Plugin{
+		{Name: strPtr("key-auth"), Enabled: boolPtr(false)}, // drifted, matched by key -> update
+		{Name: strPtr("cors")},                              // new -> create
+		// rate-limiting is absent from desired -> delete
+	}
+
+	plan := buildApplyPlan(existing, desired)
+	if len(plan) != 3 {
+		t.Fatalf("buildApplyPlan() returned %d results, want 3", len(plan))
+	}
+	if plan[0].Action != ApplyActionUpdate {
+		t.Errorf("plan[0].Action = %v, want update", plan[0].Action)
+	}
+	if plan[1].Action != ApplyActionCreate {
+		t.Errorf("plan[1].Action = %v, want create", plan[1].Action)
+	}
+	if plan[2].Action != ApplyActionDelete || *plan[2].Existing.Name != "rate-limiting" {
+		t.Errorf("plan[2] = %+v, want delete of rate-limiting", plan[2])
+	}
+}
+
+func boolPtr(b bool) *bool { return &b }
+
+func TestPluginsEqualForApply(t *testing.T) {
+	base := &Plugin{
+		Name:    strPtr("rate-limiting"),
+		Enabled: boolPtr(true),
+		Tags:    []*string{strPtr("team-a"), strPtr("prod")},
+	}
+
+	cases := []struct {
+		name  string
+		want  *Plugin
+		want2 bool
+	}{
+		{"identical", &Plugin{Name: strPtr("rate-limiting"), Enabled: boolPtr(true), Tags: []*string{strPtr("prod"), strPtr("team-a")}}, true},
+		{"different enabled", &Plugin{Name: strPtr("rate-limiting"), Enabled: boolPtr(false), Tags: base.Tags}, false},
+		{"desired enabled unset, existing set", &Plugin{Name: strPtr("rate-limiting"), Enabled: nil, Tags: base.Tags}, false},
+		{"different tags", &Plugin{Name: strPtr("rate-limiting"), Enabled: boolPtr(true), Tags: []*string{strPtr("team-b")}}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := pluginsEqualForApply(base, c.want); got != c.want2 {
+				t.Errorf("pluginsEqualForApply() = %v, want %v", got, c.want2)
+			}
+		})
+	}
+}
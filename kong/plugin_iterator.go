@@ -0,0 +1,76 @@
+package kong
+
+import "context"
+
+// PluginIterator streams Plugins page by page, fetching the next page only
+// once the current one is exhausted so callers processing large listings
+// don't have to buffer the whole set in memory the way ListAll does.
+type PluginIterator struct {
+	ctx     context.Context
+	service *PluginService
+	path    string
+
+	opt *ListOpt
+	buf []*Plugin
+
+	current *Plugin
+	err     error
+	done    bool
+}
+
+// Iterate returns a PluginIterator over /plugins, honoring opt for
+// pagination and tag filtering.
+func (s *PluginService) Iterate(ctx context.Context, opt *ListOpt) *PluginIterator {
+	if opt == nil {
+		opt = &ListOpt{Size: pageSize}
+	}
+	return &PluginIterator{
+		ctx:     ctx,
+		service: s,
+		path:    "/plugins",
+		opt:     opt,
+	}
+}
+
+// Next advances the iterator to the next Plugin, fetching a further page
+// from Kong if the buffered one is exhausted. It returns false once the
+// listing is exhausted, ctx is cancelled, or a page fetch fails; call Err
+// to distinguish the two.
+func (i *PluginIterator) Next() bool {
+	if i.err != nil || i.done {
+		return false
+	}
+	if err := i.ctx.Err(); err != nil {
+		i.err = err
+		return false
+	}
+
+	for len(i.buf) == 0 {
+		if i.opt == nil {
+			i.done = true
+			return false
+		}
+
+		data, next, err := i.service.listByPath(i.ctx, i.path, i.opt)
+		if err != nil {
+			i.err = err
+			return false
+		}
+		i.buf = data
+		i.opt = next
+	}
+
+	i.current = i.buf[0]
+	i.buf = i.buf[1:]
+	return true
+}
+
+// Plugin returns the Plugin produced by the most recent call to Next.
+func (i *PluginIterator) Plugin() *Plugin {
+	return i.current
+}
+
+// Err returns the error, if any, that stopped iteration.
+func (i *PluginIterator) Err() error {
+	return i.err
+}
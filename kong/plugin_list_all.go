@@ -0,0 +1,114 @@
+package kong
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// ListAllOpt controls how the PluginService ListAll* methods fetch pages.
+type ListAllOpt struct {
+	// Concurrency is accepted for API compatibility with the original
+	// request for a concurrent-fetch ListAll, but is currently a no-op.
+	// Kong's pagination cursor (ListOpt.Offset) is opaque and only produced
+	// by the response to the previous page, so pages cannot be fetched out
+	// of order or in parallel; there is no parallelizable axis to spawn
+	// workers over. Pages are always fetched one at a time, regardless of
+	// this value.
+	Concurrency int
+	// ListOpt seeds the first page's filtering options — Tags and
+	// MatchAllTags in particular. Size and Offset are ignored: Size is
+	// fixed to pageSize and Offset is managed internally as pagination
+	// proceeds.
+	*ListOpt
+}
+
+// initialListOpt builds the ListOpt for the first page of a ListAll*,
+// carrying opt.Tags/MatchAllTags over so Kong filters server-side instead
+// of every page being fetched in full.
+func (opt ListAllOpt) initialListOpt() *ListOpt {
+	if opt.ListOpt == nil {
+		return &ListOpt{Size: pageSize}
+	}
+	listOpt := *opt.ListOpt
+	listOpt.Size = pageSize
+	listOpt.Offset = ""
+	return &listOpt
+}
+
+// soleListAllOpt returns the first ListAllOpt passed to a variadic ListAll*
+// call, or the zero value (no tag filter) if none was given.
+func soleListAllOpt(opts []ListAllOpt) ListAllOpt {
+	if len(opts) == 0 {
+		return ListAllOpt{}
+	}
+	return opts[0]
+}
+
+// listAllByPath fetches every Plugin in Kong under path, one page at a
+// time. This method can take a while if there are a lot of Plugins present.
+//
+// Kong's pagination cursor (ListOpt.Offset) is opaque and only produced by
+// the response to the previous page, so pages can't be fetched out of
+// order or in parallel. Each page is retried with backoff so a single
+// transient 429/5xx doesn't fail the whole listing.
+func (s *PluginService) listAllByPath(ctx context.Context, path string,
+	opt ListAllOpt) ([]*Plugin, error) {
+	var plugins []*Plugin
+	listOpt := opt.initialListOpt()
+	for listOpt != nil {
+		data, next, err := s.listByPathWithBackoff(ctx, path, listOpt)
+		if err != nil {
+			return nil, err
+		}
+		plugins = append(plugins, data...)
+		listOpt = next
+	}
+	return plugins, nil
+}
+
+// listByPathWithBackoff wraps listByPath with a short exponential backoff
+// retry so a transient rate-limit or server error on one page doesn't fail
+// an entire ListAll*.
+func (s *PluginService) listByPathWithBackoff(ctx context.Context, path string,
+	opt *ListOpt) ([]*Plugin, *ListOpt, error) {
+	const maxAttempts = 4
+	backoff := 200 * time.Millisecond
+
+	var lastErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, nil, ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+
+		plugins, next, err := s.listByPath(ctx, path, opt)
+		if err == nil {
+			return plugins, next, nil
+		}
+		lastErr = err
+		if !isRetryableListError(err) {
+			return nil, nil, err
+		}
+	}
+	return nil, nil, lastErr
+}
+
+// isRetryableListError reports whether err looks like a transient HTTP
+// failure (429 or 5xx) worth retrying rather than a permanent one. It
+// matches via a narrow Code() int interface, rather than the concrete
+// *APIError type, so that any error implementation exposing the status
+// code this way (APIError included) is retried.
+func isRetryableListError(err error) bool {
+	var coder interface{ Code() int }
+	if errors.As(err, &coder) {
+		code := coder.Code()
+		return code == http.StatusTooManyRequests || code >= 500
+	}
+	return false
+}
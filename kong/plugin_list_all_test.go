@@ -0,0 +1,37 @@
+package kong
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// codedError is a minimal stand-in for go-kong's *APIError, which exposes
+// its HTTP status via Code() int.
+type codedError struct{ code int }
+
+func (e *codedError) Error() string { return fmt.Sprintf("request failed with status %d", e.code) }
+func (e *codedError) Code() int     { return e.code }
+
+func TestIsRetryableListError(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"429 is retryable", &codedError{code: http.StatusTooManyRequests}, true},
+		{"500 is retryable", &codedError{code: http.StatusInternalServerError}, true},
+		{"503 is retryable", &codedError{code: http.StatusServiceUnavailable}, true},
+		{"404 is not retryable", &codedError{code: http.StatusNotFound}, false},
+		{"plain error is not retryable", errors.New("boom"), false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := isRetryableListError(c.err); got != c.want {
+				t.Errorf("isRetryableListError(%v) = %v, want %v", c.err, got, c.want)
+			}
+		})
+	}
+}
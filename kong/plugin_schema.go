@@ -0,0 +1,205 @@
+package kong
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// FieldError describes a single problem found while validating a plugin's
+// configuration against its schema.
+type FieldError struct {
+	Field   string
+	Problem string
+}
+
+// Error implements the error interface.
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Problem)
+}
+
+// Schema fetches the JSON schema Kong uses to validate instances of the
+// named plugin.
+func (s *PluginService) Schema(ctx context.Context,
+	pluginName string) (map[string]interface{}, error) {
+	if pluginName == "" {
+		return nil, errors.New("pluginName cannot be empty")
+	}
+
+	endpoint := fmt.Sprintf("/schemas/plugins/%v", pluginName)
+	req, err := s.client.NewRequest("GET", endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var schema map[string]interface{}
+	_, err = s.client.Do(ctx, req, &schema)
+	if err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// ValidateConfig walks pluginName's schema and reports every problem found
+// in config client-side: unknown fields, fields of the wrong type, missing
+// required fields and values that fail a one_of check. An empty, non-nil
+// slice means config is valid.
+func (s *PluginService) ValidateConfig(ctx context.Context, pluginName string,
+	config Configuration) ([]FieldError, error) {
+	schema, err := s.Schema(ctx, pluginName)
+	if err != nil {
+		return nil, err
+	}
+	configFields, err := configFieldsFromSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	return validateConfigAgainstFields(configFields, config), nil
+}
+
+// validateConfigAgainstFields is the pure field-by-field check behind
+// ValidateConfig, split out so it can be exercised without a schema fetch.
+func validateConfigAgainstFields(configFields map[string]map[string]interface{},
+	config Configuration) []FieldError {
+	fieldErrors := []FieldError{}
+	seen := make(map[string]bool, len(config))
+	for name, value := range config {
+		seen[name] = true
+		fieldSchema, ok := configFields[name]
+		if !ok {
+			fieldErrors = append(fieldErrors, FieldError{Field: name, Problem: "unknown field"})
+			continue
+		}
+		if problem := checkFieldType(fieldSchema, value); problem != "" {
+			fieldErrors = append(fieldErrors, FieldError{Field: name, Problem: problem})
+		}
+		if oneOf, ok := fieldSchema["one_of"].([]interface{}); ok && len(oneOf) > 0 && !valueInOneOf(value, oneOf) {
+			fieldErrors = append(fieldErrors, FieldError{Field: name, Problem: fmt.Sprintf("must be one of %v", oneOf)})
+		}
+	}
+	for name, fieldSchema := range configFields {
+		if seen[name] {
+			continue
+		}
+		if required, _ := fieldSchema["required"].(bool); required {
+			fieldErrors = append(fieldErrors, FieldError{Field: name, Problem: "missing required field"})
+		}
+	}
+
+	sort.Slice(fieldErrors, func(i, j int) bool { return fieldErrors[i].Field < fieldErrors[j].Field })
+	return fieldErrors
+}
+
+// NewPluginFromSchema fetches name's schema and returns a Plugin pre-populated
+// with the config defaults declared in it. It takes ctx, unlike the rest of
+// this service's constructors, because building the Plugin requires a round
+// trip to /schemas/plugins/{name}.
+func (s *PluginService) NewPluginFromSchema(ctx context.Context, name string) (*Plugin, error) {
+	schema, err := s.Schema(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	configFields, err := configFieldsFromSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	config := Configuration{}
+	for fieldName, fieldSchema := range configFields {
+		if def, ok := fieldSchema["default"]; ok {
+			config[fieldName] = def
+		}
+	}
+	return &Plugin{Name: &name, Config: config}, nil
+}
+
+// configFieldsFromSchema pulls the per-field schema of a plugin's `config`
+// record out of the raw JSON schema returned by /schemas/plugins/{name},
+// keyed by field name.
+func configFieldsFromSchema(schema map[string]interface{}) (map[string]map[string]interface{}, error) {
+	rawFields, ok := schema["fields"].([]interface{})
+	if !ok {
+		return nil, errors.New("plugin schema is missing a top-level fields array")
+	}
+
+	for _, rawField := range rawFields {
+		entry, ok := rawField.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		configRaw, ok := entry["config"]
+		if !ok {
+			continue
+		}
+		configSchema, ok := configRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		innerFields, ok := configSchema["fields"].([]interface{})
+		if !ok {
+			return map[string]map[string]interface{}{}, nil
+		}
+
+		result := make(map[string]map[string]interface{}, len(innerFields))
+		for _, inner := range innerFields {
+			fieldEntry, ok := inner.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for fieldName, def := range fieldEntry {
+				if defMap, ok := def.(map[string]interface{}); ok {
+					result[fieldName] = defMap
+				}
+			}
+		}
+		return result, nil
+	}
+	return map[string]map[string]interface{}{}, nil
+}
+
+// checkFieldType reports a human-readable problem if value doesn't match
+// the Kong schema type declared in fieldSchema, or "" if it matches.
+func checkFieldType(fieldSchema map[string]interface{}, value interface{}) string {
+	wantType, _ := fieldSchema["type"].(string)
+	if wantType == "" || value == nil {
+		return ""
+	}
+
+	switch wantType {
+	case "string":
+		if _, ok := value.(string); !ok {
+			return "expected a string"
+		}
+	case "number", "integer":
+		switch value.(type) {
+		case float64, int:
+		default:
+			return "expected a number"
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return "expected a boolean"
+		}
+	case "array", "set":
+		if _, ok := value.([]interface{}); !ok {
+			return "expected an array"
+		}
+	case "record", "map":
+		if _, ok := value.(map[string]interface{}); !ok {
+			return "expected an object"
+		}
+	}
+	return ""
+}
+
+// valueInOneOf reports whether value matches one of the allowed values in
+// a schema's one_of list.
+func valueInOneOf(value interface{}, oneOf []interface{}) bool {
+	for _, allowed := range oneOf {
+		if fmt.Sprint(allowed) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
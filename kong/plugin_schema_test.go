@@ -0,0 +1,80 @@
+package kong
+
+import "testing"
+
+func testConfigFields() map[string]map[string]interface{} {
+	return map[string]map[string]interface{}{
+		"host":     {"type": "string", "required": true},
+		"port":     {"type": "integer"},
+		"strategy": {"type": "string", "one_of": []interface{}{"redis", "cluster"}},
+	}
+}
+
+func TestConfigFieldsFromSchema(t *testing.T) {
+	schema := map[string]interface{}{
+		"fields": []interface{}{
+			map[string]interface{}{"name": map[string]interface{}{"type": "string"}},
+			map[string]interface{}{
+				"config": map[string]interface{}{
+					"fields": []interface{}{
+						map[string]interface{}{"host": map[string]interface{}{"type": "string", "required": true}},
+						map[string]interface{}{"port": map[string]interface{}{"type": "integer"}},
+					},
+				},
+			},
+		},
+	}
+
+	fields, err := configFieldsFromSchema(schema)
+	if err != nil {
+		t.Fatalf("configFieldsFromSchema() error = %v", err)
+	}
+	if len(fields) != 2 {
+		t.Fatalf("configFieldsFromSchema() = %v, want 2 fields", fields)
+	}
+	if required, _ := fields["host"]["required"].(bool); !required {
+		t.Errorf("host field should be required")
+	}
+}
+
+func TestConfigFieldsFromSchema_MissingFields(t *testing.T) {
+	if _, err := configFieldsFromSchema(map[string]interface{}{}); err == nil {
+		t.Error("configFieldsFromSchema() with no top-level fields should error")
+	}
+}
+
+func TestValidateConfigAgainstFields(t *testing.T) {
+	cases := []struct {
+		name       string
+		config     Configuration
+		wantFields []string
+	}{
+		{"valid config", Configuration{"host": "example.com", "port": float64(80)}, nil},
+		{"unknown field", Configuration{"host": "example.com", "bogus": true}, []string{"bogus"}},
+		{"missing required field", Configuration{"port": float64(80)}, []string{"host"}},
+		{"wrong type", Configuration{"host": 123, "port": float64(80)}, []string{"host"}},
+		{"failed one_of", Configuration{"host": "example.com", "strategy": "memcached"}, []string{"strategy"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			errs := validateConfigAgainstFields(testConfigFields(), c.config)
+			if errs == nil {
+				t.Fatal("validateConfigAgainstFields() returned a nil slice, want a non-nil slice")
+			}
+			var gotFields []string
+			for _, e := range errs {
+				gotFields = append(gotFields, e.Field)
+			}
+			if len(gotFields) != len(c.wantFields) {
+				t.Fatalf("validateConfigAgainstFields() fields = %v, want %v", gotFields, c.wantFields)
+			}
+			for i, f := range c.wantFields {
+				if gotFields[i] != f {
+					t.Errorf("validateConfigAgainstFields() fields = %v, want %v", gotFields, c.wantFields)
+					break
+				}
+			}
+		})
+	}
+}
@@ -21,13 +21,15 @@ type AbstractPluginService interface {
 	// List fetches a list of Plugins in Kong.
 	List(ctx context.Context, opt *ListOpt) ([]*Plugin, *ListOpt, error)
 	// ListAll fetches all Plugins in Kong.
-	ListAll(ctx context.Context) ([]*Plugin, error)
+	ListAll(ctx context.Context, opts ...ListAllOpt) ([]*Plugin, error)
 	// ListAllForConsumer fetches all Plugins in Kong enabled for a consumer.
-	ListAllForConsumer(ctx context.Context, consumerIDorName *string) ([]*Plugin, error)
+	ListAllForConsumer(ctx context.Context, consumerIDorName *string, opts ...ListAllOpt) ([]*Plugin, error)
 	// ListAllForService fetches all Plugins in Kong enabled for a service.
-	ListAllForService(ctx context.Context, serviceIDorName *string) ([]*Plugin, error)
+	ListAllForService(ctx context.Context, serviceIDorName *string, opts ...ListAllOpt) ([]*Plugin, error)
 	// ListAllForRoute fetches all Plugins in Kong enabled for a service.
-	ListAllForRoute(ctx context.Context, routeID *string) ([]*Plugin, error)
+	ListAllForRoute(ctx context.Context, routeID *string, opts ...ListAllOpt) ([]*Plugin, error)
+	// ListAllForConsumerGroup fetches all Plugins in Kong enabled for a consumer group.
+	ListAllForConsumerGroup(ctx context.Context, consumerGroupIDorName *string, opts ...ListAllOpt) ([]*Plugin, error)
 	// Validate validates a Plugin against its schema
 	Validate(ctx context.Context, plugin *Plugin) (bool, error)
 }
@@ -43,6 +45,12 @@ func (s *PluginService) Create(ctx context.Context,
 	plugin *Plugin) (*Plugin, error) {
 
 	queryPath := "/plugins"
+	if plugin.ConsumerGroup != nil {
+		if isEmptyString(plugin.ConsumerGroup.ID) {
+			return nil, errors.New("plugin.ConsumerGroup.ID cannot be nil for Create operation")
+		}
+		queryPath = fmt.Sprintf("/consumer_groups/%v/plugins", *plugin.ConsumerGroup.ID)
+	}
 	method := "POST"
 	if plugin.ID != nil {
 		queryPath = queryPath + "/" + *plugin.ID
@@ -93,9 +101,28 @@ func (s *PluginService) Update(ctx context.Context,
 	if !isEmptyString(plugin.ID) {
 		keyPlugin = *plugin.ID
 	}
-	if plugin.Service != nil {
-		endpoint = fmt.Sprintf("/services/%v/plugins/%v",plugin.Service.ID, keyPlugin)
-	} else {
+	switch {
+	case plugin.Service != nil:
+		if isEmptyString(plugin.Service.ID) {
+			return nil, errors.New("plugin.Service.ID cannot be nil for Update operation")
+		}
+		endpoint = fmt.Sprintf("/services/%v/plugins/%v", *plugin.Service.ID, keyPlugin)
+	case plugin.Route != nil:
+		if isEmptyString(plugin.Route.ID) {
+			return nil, errors.New("plugin.Route.ID cannot be nil for Update operation")
+		}
+		endpoint = fmt.Sprintf("/routes/%v/plugins/%v", *plugin.Route.ID, keyPlugin)
+	case plugin.Consumer != nil:
+		if isEmptyString(plugin.Consumer.ID) {
+			return nil, errors.New("plugin.Consumer.ID cannot be nil for Update operation")
+		}
+		endpoint = fmt.Sprintf("/consumers/%v/plugins/%v", *plugin.Consumer.ID, keyPlugin)
+	case plugin.ConsumerGroup != nil:
+		if isEmptyString(plugin.ConsumerGroup.ID) {
+			return nil, errors.New("plugin.ConsumerGroup.ID cannot be nil for Update operation")
+		}
+		endpoint = fmt.Sprintf("/consumer_groups/%v/plugins/%v", *plugin.ConsumerGroup.ID, keyPlugin)
+	default:
 		endpoint = fmt.Sprintf("/plugins/%v", keyPlugin)
 	}
 
@@ -175,25 +202,6 @@ func (s *PluginService) listByPath(ctx context.Context,
 	return plugins, next, nil
 }
 
-// ListAll fetches all Plugins in Kong.
-// This method can take a while if there
-// a lot of Plugins present.
-func (s *PluginService) listAllByPath(ctx context.Context,
-	path string) ([]*Plugin, error) {
-	var plugins, data []*Plugin
-	var err error
-	opt := &ListOpt{Size: pageSize}
-
-	for opt != nil {
-		data, opt, err = s.listByPath(ctx, path, opt)
-		if err != nil {
-			return nil, err
-		}
-		plugins = append(plugins, data...)
-	}
-	return plugins, nil
-}
-
 // List fetches a list of Plugins in Kong.
 // opt can be used to control pagination.
 func (s *PluginService) List(ctx context.Context,
@@ -203,34 +211,44 @@ func (s *PluginService) List(ctx context.Context,
 
 // ListAll fetches all Plugins in Kong.
 // This method can take a while if there
-// a lot of Plugins present.
-func (s *PluginService) ListAll(ctx context.Context) ([]*Plugin, error) {
-	return s.listAllByPath(ctx, "/plugins")
+// a lot of Plugins present. opts can be used to filter by tag; see
+// ListAllOpt for why it doesn't fetch pages concurrently.
+func (s *PluginService) ListAll(ctx context.Context, opts ...ListAllOpt) ([]*Plugin, error) {
+	return s.listAllByPath(ctx, "/plugins", soleListAllOpt(opts))
 }
 
 // ListAllForConsumer fetches all Plugins in Kong enabled for a consumer.
 func (s *PluginService) ListAllForConsumer(ctx context.Context,
-	consumerIDorName *string) ([]*Plugin, error) {
+	consumerIDorName *string, opts ...ListAllOpt) ([]*Plugin, error) {
 	if isEmptyString(consumerIDorName) {
 		return nil, errors.New("consumerIDorName cannot be nil")
 	}
-	return s.listAllByPath(ctx, "/consumers/"+*consumerIDorName+"/plugins")
+	return s.listAllByPath(ctx, "/consumers/"+*consumerIDorName+"/plugins", soleListAllOpt(opts))
 }
 
 // ListAllForService fetches all Plugins in Kong enabled for a service.
 func (s *PluginService) ListAllForService(ctx context.Context,
-	serviceIDorName *string) ([]*Plugin, error) {
+	serviceIDorName *string, opts ...ListAllOpt) ([]*Plugin, error) {
 	if isEmptyString(serviceIDorName) {
 		return nil, errors.New("serviceIDorName cannot be nil")
 	}
-	return s.listAllByPath(ctx, "/services/"+*serviceIDorName+"/plugins")
+	return s.listAllByPath(ctx, "/services/"+*serviceIDorName+"/plugins", soleListAllOpt(opts))
 }
 
 // ListAllForRoute fetches all Plugins in Kong enabled for a service.
 func (s *PluginService) ListAllForRoute(ctx context.Context,
-	routeID *string) ([]*Plugin, error) {
+	routeID *string, opts ...ListAllOpt) ([]*Plugin, error) {
 	if isEmptyString(routeID) {
 		return nil, errors.New("routeID cannot be nil")
 	}
-	return s.listAllByPath(ctx, "/routes/"+*routeID+"/plugins")
+	return s.listAllByPath(ctx, "/routes/"+*routeID+"/plugins", soleListAllOpt(opts))
+}
+
+// ListAllForConsumerGroup fetches all Plugins in Kong enabled for a consumer group.
+func (s *PluginService) ListAllForConsumerGroup(ctx context.Context,
+	consumerGroupIDorName *string, opts ...ListAllOpt) ([]*Plugin, error) {
+	if isEmptyString(consumerGroupIDorName) {
+		return nil, errors.New("consumerGroupIDorName cannot be nil")
+	}
+	return s.listAllByPath(ctx, "/consumer_groups/"+*consumerGroupIDorName+"/plugins", soleListAllOpt(opts))
 }